@@ -2,42 +2,46 @@ package gl
 
 import (
 	"fyne.io/fyne"
+	"fyne.io/fyne/driver/desktop"
 	"fyne.io/fyne/widget"
 )
 
-type menuBarAction struct {
-	Label string
-
-	menu   *fyne.Menu
-	canvas fyne.Canvas
+func buildMenuBar(menus *fyne.MainMenu, w fyne.Window) *widget.MenuBar {
+	for _, menu := range menus.Items {
+		registerAccelerators(menu, w.Canvas())
+	}
+	bar := widget.NewMenuBar(menus, w.Canvas())
+	bindAltToFocusMenuBar(bar, w)
+	return bar
 }
 
-// ToolbarObject gets a button to render this ToolbarAction
-func (m *menuBarAction) ToolbarObject() fyne.CanvasObject {
-	button := widget.NewButton(m.Label, nil)
-
-	button.OnTapped = func() {
-		pos := button.Position().Add(fyne.NewPos(0, button.Size().Height))
-		showMenu(m.menu, pos, m.canvas)
+// bindAltToFocusMenuBar gives bar keyboard focus whenever Alt is pressed, entering
+// "menubar focus mode" without requiring an initial click on one of its menus.
+func bindAltToFocusMenuBar(bar *widget.MenuBar, w fyne.Window) {
+	dc, ok := w.Canvas().(desktop.Canvas)
+	if !ok {
+		return
 	}
 
-	return button
-}
-
-func newMenuBarAction(menu *fyne.Menu, w fyne.Window) widget.ToolbarItem {
-	return &menuBarAction{menu.Label, menu, w.Canvas()}
+	dc.SetOnKeyDown(func(ev *fyne.KeyEvent) {
+		if ev.Name == fyne.KeyLeftAlt || ev.Name == fyne.KeyRightAlt {
+			w.Canvas().Focus(bar)
+		}
+	})
 }
 
-func buildMenuBar(menus *fyne.MainMenu, w fyne.Window) *widget.Toolbar {
-	var items []widget.ToolbarItem
-
-	for _, menu := range menus.Items {
-		items = append(items, newMenuBarAction(menu, w))
+// registerAccelerators walks a menu (and its submenus) registering each
+// item's Accelerator on the canvas, so it fires even while the menu is closed.
+func registerAccelerators(menu *fyne.Menu, c fyne.Canvas) {
+	for _, item := range menu.Items {
+		if item.Accelerator != nil && item.Action != nil {
+			action := item.Action
+			c.AddShortcut(item.Accelerator, func(fyne.Shortcut) {
+				action()
+			})
+		}
+		if item.ChildMenu != nil {
+			registerAccelerators(item.ChildMenu, c)
+		}
 	}
-	return widget.NewToolbar(items...)
-}
-
-func showMenu(menu *fyne.Menu, pos fyne.Position, c fyne.Canvas) {
-	pop := widget.NewPopUpMenu(fyne.NewMenu("", menu.Items...), c)
-	pop.Move(pos)
 }
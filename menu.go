@@ -0,0 +1,68 @@
+package fyne
+
+// MenuItem, Menu and MainMenu are the core menu model types; the Accelerator,
+// Checked, Checkable and RadioGroup fields below are this release's additions
+// to an otherwise pre-existing model.
+
+// MenuItem is a single item within any menu, it can optionally have a submenu.
+type MenuItem struct {
+	ChildMenu   *Menu
+	IsSeparator bool
+	Label       string
+	Action      func() `json:"-"`
+
+	// Accelerator is a shortcut that triggers Action even while the menu is closed.
+	// It is rendered next to the item's label when the menu is open.
+	//
+	// Since: 1.4
+	Accelerator Shortcut
+
+	// Checked indicates whether a checkable item currently shows its check mark.
+	//
+	// Since: 1.4
+	Checked bool
+
+	// Checkable marks this item as toggling Checked when tapped.
+	//
+	// Since: 1.4
+	Checkable bool
+
+	// RadioGroup, if non-empty, makes this item part of a radio group: checking
+	// it clears Checked on every other item within the same Menu that shares
+	// this RadioGroup value.
+	//
+	// Since: 1.4
+	RadioGroup string
+}
+
+// NewMenuItem creates a new menu item from the passed label and action parameters.
+func NewMenuItem(label string, action func()) *MenuItem {
+	return &MenuItem{Label: label, Action: action}
+}
+
+// NewMenuItemSeparator creates a new separator item.
+func NewMenuItemSeparator() *MenuItem {
+	return &MenuItem{IsSeparator: true}
+}
+
+// Menu stores the information required for a standard menu.
+// It can be shown as a popup, attached to a MainMenu, or added as a submenu of a MenuItem.
+type Menu struct {
+	Label string
+	Items []*MenuItem
+}
+
+// NewMenu creates a new menu given a label and child items.
+func NewMenu(label string, items ...*MenuItem) *Menu {
+	return &Menu{Label: label, Items: items}
+}
+
+// MainMenu defines the data required to show a menu bar (or appropriate equivalent).
+type MainMenu struct {
+	Items []*Menu
+}
+
+// NewMainMenu creates a new menu bar structure from a list of top level menus.
+func NewMainMenu(items ...*Menu) *MainMenu {
+	return &MainMenu{Items: items}
+}
@@ -0,0 +1,6 @@
+package fyne
+
+// Shortcut is the interface used for all shortcuts and shortcutable widgets.
+type Shortcut interface {
+	ShortcutName() string
+}
@@ -2,6 +2,7 @@ package widget
 
 import (
 	"image/color"
+	"math"
 
 	"fyne.io/fyne"
 	"fyne.io/fyne/canvas"
@@ -25,6 +26,11 @@ type ButtonImportance int
 // ButtonStyle determines the behaviour and rendering of a button.
 type ButtonStyle int
 
+// ButtonAnimation selects the tap feedback animation played by a Button.
+//
+// Since: 1.4
+type ButtonAnimation int
+
 const (
 	// ButtonAlignCenter aligns the icon and the text centrally.
 	ButtonAlignCenter ButtonAlign = iota
@@ -50,6 +56,21 @@ const (
 	LowImportance
 )
 
+const (
+	// ButtonAnimationSweep expands a rectangle outward from the button's centre. This is the default.
+	//
+	// Since: 1.4
+	ButtonAnimationSweep ButtonAnimation = iota
+	// ButtonAnimationRipple expands a circle outward from the tapped point, Material Design style.
+	//
+	// Since: 1.4
+	ButtonAnimationRipple
+	// ButtonAnimationNone disables the tap animation.
+	//
+	// Since: 1.4
+	ButtonAnimationNone
+)
+
 // Button widget has a text label and triggers an event func when clicked
 type Button struct {
 	DisableableWidget
@@ -62,11 +83,23 @@ type Button struct {
 	Alignment     ButtonAlign
 	IconPlacement ButtonIconPlacement
 
+	// Animation selects the tap feedback played by the button.
+	//
+	// Since: 1.4
+	Animation ButtonAnimation
+
+	// ContextMenu, when set, is shown on a secondary tap (right-click, or long-press on mobile).
+	//
+	// Since: 1.4
+	ContextMenu *fyne.Menu
+
 	OnTapped func() `json:"-"`
 
-	hovered bool
-	tapAnim *fyne.Animation
-	tapBG   *canvas.Rectangle
+	hovered   bool
+	tapAnim   *fyne.Animation
+	tapBG     *canvas.Rectangle
+	tapCircle *canvas.Circle
+	tapPoint  fyne.Position
 }
 
 // NewButton creates a new button widget with the set label and tap handler
@@ -100,9 +133,11 @@ func (b *Button) CreateRenderer() fyne.WidgetRenderer {
 
 	background := canvas.NewRectangle(theme.ButtonColor())
 	b.tapBG = canvas.NewRectangle(color.Transparent)
+	b.tapCircle = canvas.NewCircle(color.Transparent)
 	objects := []fyne.CanvasObject{
 		background,
 		b.tapBG,
+		b.tapCircle,
 		text,
 	}
 	shadowLevel := widget.ButtonLevel
@@ -163,11 +198,12 @@ func (b *Button) SetText(text string) {
 }
 
 // Tapped is called when a pointer tapped event is captured and triggers any tap handler
-func (b *Button) Tapped(*fyne.PointEvent) {
+func (b *Button) Tapped(evt *fyne.PointEvent) {
 	if b.Disabled() {
 		return
 	}
 
+	b.tapPoint = evt.Position
 	b.tapAnimation()
 	b.Refresh()
 
@@ -176,18 +212,40 @@ func (b *Button) Tapped(*fyne.PointEvent) {
 	}
 }
 
+// TappedSecondary shows ContextMenu, if set, anchored at the event position.
+// Implements: fyne.SecondaryTappable
+func (b *Button) TappedSecondary(evt *fyne.PointEvent) {
+	if b.Disabled() || b.ContextMenu == nil {
+		return
+	}
+
+	c := fyne.CurrentApp().Driver().CanvasForObject(b)
+	if c == nil {
+		return
+	}
+
+	ShowPopUpMenuAtPosition(b.ContextMenu, c, evt.AbsolutePosition)
+}
+
 func (b *Button) tapAnimation() {
 	if b.tapBG == nil { // not rendered yet? (tests)
 		return
 	}
 
-	if b.tapAnim == nil {
-		b.tapAnim = newButtonTapAnimation(b.tapBG, b)
-		b.tapAnim.Curve = fyne.AnimationEaseOut
-	} else {
+	if b.tapAnim != nil {
 		b.tapAnim.Stop()
 	}
 
+	if b.Animation == ButtonAnimationNone {
+		return
+	}
+
+	if b.Animation == ButtonAnimationRipple {
+		b.tapAnim = newButtonRippleAnimation(b.tapCircle, b, b.tapPoint)
+	} else {
+		b.tapAnim = newButtonTapAnimation(b.tapBG, b)
+	}
+	b.tapAnim.Curve = fyne.AnimationEaseOut
 	b.tapAnim.Start()
 }
 
@@ -331,12 +389,22 @@ func (r *buttonRenderer) padding() fyne.Size {
 	return fyne.NewSize(theme.Padding()*6, theme.Padding()*4)
 }
 
+// baseObjects returns the renderer's object list in the order CreateRenderer builds it,
+// so every call site that rebuilds the list via SetObjects stays in sync.
+func (r *buttonRenderer) baseObjects() []fyne.CanvasObject {
+	objects := []fyne.CanvasObject{r.background, r.button.tapBG, r.button.tapCircle, r.label}
+	if r.icon != nil {
+		objects = append(objects, r.icon)
+	}
+	return objects
+}
+
 func (r *buttonRenderer) updateIconAndText() {
 	if r.button.Icon != nil && r.button.Visible() {
 		if r.icon == nil {
 			r.icon = canvas.NewImageFromResource(r.button.Icon)
 			r.icon.FillMode = canvas.ImageFillContain
-			r.SetObjects([]fyne.CanvasObject{r.background, r.button.tapBG, r.label, r.icon})
+			r.SetObjects(r.baseObjects())
 		}
 		if r.button.Disabled() {
 			r.icon.Resource = theme.NewDisabledResource(r.button.Icon)
@@ -382,3 +450,61 @@ func newButtonTapAnimation(bg *canvas.Rectangle, w fyne.Widget) *fyne.Animation
 		canvas.Refresh(bg)
 	})
 }
+
+// newButtonRippleAnimation expands a circle from point outward until it covers the
+// whole of w, fading from theme.PressedColor() to transparent as it grows. The circle's
+// bounding box is clamped to w's bounds on every frame so it never paints outside the button.
+func newButtonRippleAnimation(circle *canvas.Circle, w fyne.Widget, point fyne.Position) *fyne.Animation {
+	return fyne.NewAnimation(canvas.DurationStandard, func(done float32) {
+		size := w.Size()
+		radius := maxDistanceToCorner(point, size) * done
+
+		circle.Position1 = clampToBounds(fyne.NewPos(point.X-radius, point.Y-radius), size)
+		circle.Position2 = clampToBounds(fyne.NewPos(point.X+radius, point.Y+radius), size)
+
+		r, g, bb, a := theme.PressedColor().RGBA()
+		aa := uint8(a)
+		fade := aa - uint8(float32(aa)*done)
+		circle.FillColor = &color.NRGBA{R: uint8(r), G: uint8(g), B: uint8(bb), A: fade}
+		canvas.Refresh(circle)
+	})
+}
+
+// clampToBounds constrains pos to the rectangle [0, size], so a shape built from clamped
+// corners never extends past the widget it belongs to.
+func clampToBounds(pos fyne.Position, size fyne.Size) fyne.Position {
+	switch {
+	case pos.X < 0:
+		pos.X = 0
+	case pos.X > size.Width:
+		pos.X = size.Width
+	}
+	switch {
+	case pos.Y < 0:
+		pos.Y = 0
+	case pos.Y > size.Height:
+		pos.Y = size.Height
+	}
+	return pos
+}
+
+// maxDistanceToCorner returns the distance from point to the furthest corner of size,
+// the radius a ripple centred on point needs to fully cover it.
+func maxDistanceToCorner(point fyne.Position, size fyne.Size) float32 {
+	corners := [4]fyne.Position{
+		{X: 0, Y: 0},
+		{X: size.Width, Y: 0},
+		{X: 0, Y: size.Height},
+		{X: size.Width, Y: size.Height},
+	}
+
+	var max float32
+	for _, corner := range corners {
+		dx := corner.X - point.X
+		dy := corner.Y - point.Y
+		if dist := float32(math.Hypot(float64(dx), float64(dy))); dist > max {
+			max = dist
+		}
+	}
+	return max
+}
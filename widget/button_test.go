@@ -0,0 +1,41 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne"
+	"fyne.io/fyne/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestButton_RippleStaysWithinBounds(t *testing.T) {
+	button := NewButton("Hi", nil)
+	button.Animation = ButtonAnimationRipple
+	button.Resize(fyne.NewSize(100, 40))
+	button.CreateRenderer()
+
+	button.Tapped(&fyne.PointEvent{Position: fyne.NewPos(95, 5)})
+	button.tapAnim.Tick(1)
+	assert.Equal(t, fyne.NewPos(0, 0), button.tapCircle.Position1)
+	assert.Equal(t, fyne.NewPos(100, 40), button.tapCircle.Position2)
+
+	p1 := clampToBounds(fyne.NewPos(-500, -500), button.Size())
+	p2 := clampToBounds(fyne.NewPos(500, 500), button.Size())
+	assert.Equal(t, fyne.NewPos(0, 0), p1)
+	assert.Equal(t, fyne.NewPos(button.Size().Width, button.Size().Height), p2)
+}
+
+func TestButtonRenderer_IconIncludesTapCircle(t *testing.T) {
+	button := NewButtonWithIcon("Hi", theme.ConfirmIcon(), nil)
+	r := button.CreateRenderer().(*buttonRenderer)
+
+	r.updateIconAndText()
+
+	found := false
+	for _, o := range r.Objects() {
+		if o == button.tapCircle {
+			found = true
+		}
+	}
+	assert.True(t, found, "tapCircle must remain in the object list once an icon is set")
+}
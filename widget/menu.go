@@ -0,0 +1,279 @@
+package widget
+
+import (
+	"fyne.io/fyne"
+	"fyne.io/fyne/canvas"
+	"fyne.io/fyne/internal/widget"
+)
+
+var _ fyne.Widget = (*Menu)(nil)
+var _ fyne.Focusable = (*Menu)(nil)
+
+// Menu is a widget for displaying a fyne.Menu.
+type Menu struct {
+	widget.Base
+	Items []fyne.CanvasObject
+
+	// DismissAction is called whenever the menu dismisses itself.
+	DismissAction func()
+
+	// leaveAction, if set, is called when Left is pressed while this menu has no
+	// active child of its own - i.e. this menu is itself the innermost open submenu
+	// and Left should step back out to whichever menu opened it.
+	leaveAction func()
+
+	// advanceAction, if set, is called when Right is pressed while the active item
+	// has no child of its own - i.e. there is nowhere further right to go within
+	// this menu, so whichever owner opened it (a MenuBar, say) should move on.
+	advanceAction func()
+
+	activeItem  *menuItem
+	activeChild *Menu
+}
+
+// NewMenu creates a new Menu.
+func NewMenu(menu *fyne.Menu) *Menu {
+	m := &Menu{DismissAction: func() {}}
+	m.ExtendBaseWidget(m)
+	m.setMenu(menu)
+	return m
+}
+
+// CreateRenderer returns a new renderer for the menu.
+// Implements: fyne.Widget
+func (m *Menu) CreateRenderer() fyne.WidgetRenderer {
+	m.ExtendBaseWidget(m)
+	return &menuRenderer{BaseRenderer: widget.NewBaseRenderer(m.Items), m: m}
+}
+
+// ExtendBaseWidget initializes the widget and lets it behave as though it had been subclassed.
+func (m *Menu) ExtendBaseWidget(wid fyne.Widget) {
+	widget.ExtendBaseWidget(&m.Base, wid)
+}
+
+// Hide hides the menu.
+// Implements: fyne.Widget
+func (m *Menu) Hide() {
+	widget.HideWidget(&m.Base, m)
+}
+
+// MinSize returns the minimal size of the menu.
+// Implements: fyne.Widget
+func (m *Menu) MinSize() fyne.Size {
+	return widget.MinSizeOf(m)
+}
+
+// Refresh triggers a redraw of the menu.
+// Implements: fyne.Widget
+func (m *Menu) Refresh() {
+	widget.RefreshWidget(m)
+}
+
+// Resize changes the size of the menu.
+// Implements: fyne.Widget
+func (m *Menu) Resize(size fyne.Size) {
+	widget.ResizeWidget(&m.Base, m, size)
+}
+
+// Show makes the menu visible.
+// Implements: fyne.Widget
+func (m *Menu) Show() {
+	widget.ShowWidget(&m.Base, m)
+}
+
+// TypedKey drives the menu from the keyboard: Up/Down move the active item,
+// Right opens a submenu (activating its first item and moving focus into it) or,
+// if the active item has none, calls advanceAction; Left closes the innermost open
+// submenu and restores focus to its parent, or calls leaveAction if there is none;
+// Enter fires the active item's action and Escape dismisses the menu.
+// Implements: fyne.Focusable
+func (m *Menu) TypedKey(ev *fyne.KeyEvent) {
+	switch ev.Name {
+	case fyne.KeyUp:
+		m.activatePrevious()
+	case fyne.KeyDown:
+		m.activateNext()
+	case fyne.KeyRight:
+		if m.activeItem != nil && m.activeItem.child != nil {
+			m.activateChildOfActive()
+		} else if m.advanceAction != nil {
+			m.advanceAction()
+		}
+	case fyne.KeyLeft:
+		if m.activeChild != nil {
+			m.DeactivateChild()
+		} else if m.leaveAction != nil {
+			m.leaveAction()
+		}
+	case fyne.KeyEnter, fyne.KeyReturn:
+		m.triggerActive()
+	case fyne.KeyEscape:
+		m.Dismiss()
+	}
+}
+
+// TypedRune does nothing, menus are not driven by rune input.
+// Implements: fyne.Focusable
+func (m *Menu) TypedRune(rune) {
+}
+
+// FocusGained does nothing.
+// Implements: fyne.Focusable
+func (m *Menu) FocusGained() {
+}
+
+// FocusLost does nothing.
+// Implements: fyne.Focusable
+func (m *Menu) FocusLost() {
+}
+
+// DeactivateChild hides the currently open submenu, if any.
+func (m *Menu) DeactivateChild() {
+	if m.activeChild != nil {
+		m.activeChild.DeactivateChild()
+		m.activeChild.Hide()
+		m.activeChild = nil
+	}
+}
+
+// Dismiss closes this menu, and any open submenu, via DismissAction.
+func (m *Menu) Dismiss() {
+	m.DeactivateChild()
+	if m.DismissAction != nil {
+		m.DismissAction()
+	}
+}
+
+func (m *Menu) setMenu(menu *fyne.Menu) {
+	m.Items = make([]fyne.CanvasObject, len(menu.Items))
+	for i, item := range menu.Items {
+		if item.IsSeparator {
+			m.Items[i] = newMenuItemSeparator()
+			continue
+		}
+		m.Items[i] = newMenuItem(item, m)
+	}
+}
+
+func (m *Menu) menuItems() []*menuItem {
+	items := make([]*menuItem, 0, len(m.Items))
+	for _, o := range m.Items {
+		if mi, ok := o.(*menuItem); ok {
+			items = append(items, mi)
+		}
+	}
+	return items
+}
+
+func (m *Menu) activateNext() {
+	items := m.menuItems()
+	if len(items) == 0 {
+		return
+	}
+	idx := m.activeItemIndex(items) + 1
+	if idx >= len(items) {
+		idx = 0
+	}
+	m.activateItemAt(items, idx)
+}
+
+func (m *Menu) activatePrevious() {
+	items := m.menuItems()
+	if len(items) == 0 {
+		return
+	}
+	idx := m.activeItemIndex(items) - 1
+	if idx < 0 {
+		idx = len(items) - 1
+	}
+	m.activateItemAt(items, idx)
+}
+
+func (m *Menu) activateFirst() {
+	items := m.menuItems()
+	if len(items) == 0 {
+		return
+	}
+	m.activateItemAt(items, 0)
+}
+
+func (m *Menu) activeItemIndex(items []*menuItem) int {
+	for i, it := range items {
+		if it == m.activeItem {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *Menu) activateItemAt(items []*menuItem, idx int) {
+	if m.activeItem != nil {
+		m.activeItem.hovered = false
+		m.activeItem.Refresh()
+	}
+	m.activeItem = items[idx]
+	m.activeItem.hovered = true
+	m.activeItem.Refresh()
+}
+
+func (m *Menu) activateChildOfActive() {
+	if m.activeItem == nil || m.activeItem.child == nil {
+		return
+	}
+	m.activeItem.activateChild()
+
+	child := m.activeItem.child
+	child.activateFirst()
+	child.leaveAction = func() {
+		m.DeactivateChild()
+		m.focus()
+	}
+	child.focus()
+}
+
+// focus gives this menu keyboard focus, so its own TypedKey handles further navigation.
+func (m *Menu) focus() {
+	d := fyne.CurrentApp().Driver()
+	c := d.CanvasForObject(m)
+	if c != nil {
+		c.Focus(m)
+	}
+}
+
+func (m *Menu) triggerActive() {
+	if m.activeItem == nil {
+		return
+	}
+	m.activeItem.Tapped(&fyne.PointEvent{})
+}
+
+type menuRenderer struct {
+	widget.BaseRenderer
+	m *Menu
+}
+
+func (r *menuRenderer) Layout(size fyne.Size) {
+	pos := fyne.NewPos(0, 0)
+	for _, item := range r.m.Items {
+		height := item.MinSize().Height
+		item.Resize(fyne.NewSize(size.Width, height))
+		item.Move(pos)
+		pos = pos.Add(fyne.NewPos(0, height))
+	}
+}
+
+func (r *menuRenderer) MinSize() fyne.Size {
+	minSize := fyne.NewSize(0, 0)
+	for _, item := range r.m.Items {
+		itemMin := item.MinSize()
+		if itemMin.Width > minSize.Width {
+			minSize.Width = itemMin.Width
+		}
+		minSize.Height += itemMin.Height
+	}
+	return minSize
+}
+
+func (r *menuRenderer) Refresh() {
+	canvas.Refresh(r.m)
+}
@@ -0,0 +1,330 @@
+package widget
+
+import (
+	"image/color"
+
+	"fyne.io/fyne"
+	"fyne.io/fyne/canvas"
+	"fyne.io/fyne/driver/desktop"
+	"fyne.io/fyne/internal/widget"
+	"fyne.io/fyne/layout"
+	"fyne.io/fyne/theme"
+)
+
+var _ fyne.Widget = (*MenuBar)(nil)
+var _ fyne.Focusable = (*MenuBar)(nil)
+
+// MenuBar is a widget that renders a fyne.MainMenu as a row of top level
+// menus, coordinating a single open menu across all of its entries so that
+// hovering across the bar switches menus and arrow keys can traverse them.
+//
+// Since: 1.4
+type MenuBar struct {
+	widget.Base
+	Items []*menuBarItem
+
+	active     bool
+	activeItem *menuBarItem
+	canvas     fyne.Canvas
+}
+
+// NewMenuBar creates a menu bar populated with the top level menus of main.
+//
+// Since: 1.4
+func NewMenuBar(main *fyne.MainMenu, c fyne.Canvas) *MenuBar {
+	bar := &MenuBar{canvas: c}
+	bar.ExtendBaseWidget(bar)
+	bar.Items = make([]*menuBarItem, len(main.Items))
+	for i, menu := range main.Items {
+		bar.Items[i] = newMenuBarItem(menu, bar)
+	}
+	return bar
+}
+
+// CreateRenderer returns a new renderer for the menu bar.
+// Implements: fyne.Widget
+func (b *MenuBar) CreateRenderer() fyne.WidgetRenderer {
+	b.ExtendBaseWidget(b)
+	objects := make([]fyne.CanvasObject, len(b.Items))
+	for i, item := range b.Items {
+		objects[i] = item
+	}
+	return &menuBarRenderer{BaseRenderer: widget.NewBaseRenderer(objects), b: b, box: layout.NewHBoxLayout()}
+}
+
+// ExtendBaseWidget initializes the widget and lets it behave as though it had been subclassed.
+func (b *MenuBar) ExtendBaseWidget(wid fyne.Widget) {
+	widget.ExtendBaseWidget(&b.Base, wid)
+}
+
+// FocusGained puts the bar into menubar focus mode, where arrow keys traverse its menus.
+// Implements: fyne.Focusable
+func (b *MenuBar) FocusGained() {
+}
+
+// FocusLost closes any open menu and leaves menubar focus mode.
+// Implements: fyne.Focusable
+func (b *MenuBar) FocusLost() {
+	b.deactivate()
+}
+
+// TypedKey traverses top level menus with the arrow keys and opens or closes the active one.
+// Implements: fyne.Focusable
+func (b *MenuBar) TypedKey(ev *fyne.KeyEvent) {
+	switch ev.Name {
+	case fyne.KeyLeft:
+		b.activateAt(b.activeIndex() - 1)
+	case fyne.KeyRight:
+		b.activateAt(b.activeIndex() + 1)
+	case fyne.KeyDown:
+		if idx := b.activeIndex(); idx >= 0 {
+			b.activate(b.Items[idx])
+		} else if len(b.Items) > 0 {
+			b.activate(b.Items[0])
+		}
+	case fyne.KeyEscape:
+		b.deactivate()
+	}
+}
+
+// TypedRune does nothing, the menu bar is not driven by rune input.
+// Implements: fyne.Focusable
+func (b *MenuBar) TypedRune(rune) {
+}
+
+// activate opens item's menu, switching away from any menu that is currently open, and moves
+// keyboard focus into it so the submenu's own TypedKey handles further Up/Down traversal.
+func (b *MenuBar) activate(item *menuBarItem) {
+	if b.activeItem == item {
+		return
+	}
+	if b.activeItem != nil {
+		b.activeItem.closeMenu()
+	}
+	b.activeItem = item
+	b.active = true
+	item.openMenu()
+	if b.canvas != nil && item.child != nil {
+		b.canvas.Focus(item.child)
+	}
+}
+
+// toggle opens item's menu, or closes it if item is already the open one.
+func (b *MenuBar) toggle(item *menuBarItem) {
+	if b.activeItem == item {
+		b.deactivate()
+		return
+	}
+	b.activate(item)
+}
+
+// deactivate closes whatever menu is open and returns keyboard focus to the bar itself, so Escape
+// bubbling up from a submenu (via Menu.DismissAction) leaves the bar ready for further traversal.
+func (b *MenuBar) deactivate() {
+	if b.activeItem != nil {
+		b.activeItem.closeMenu()
+		b.activeItem = nil
+	}
+	b.active = false
+	if b.canvas != nil {
+		b.canvas.Focus(b)
+	}
+}
+
+func (b *MenuBar) activeIndex() int {
+	for i, item := range b.Items {
+		if item == b.activeItem {
+			return i
+		}
+	}
+	return -1
+}
+
+func (b *MenuBar) activateAt(idx int) {
+	if len(b.Items) == 0 {
+		return
+	}
+	if idx < 0 {
+		idx = len(b.Items) - 1
+	} else if idx >= len(b.Items) {
+		idx = 0
+	}
+	b.activate(b.Items[idx])
+}
+
+type menuBarRenderer struct {
+	widget.BaseRenderer
+	b   *MenuBar
+	box fyne.Layout
+}
+
+func (r *menuBarRenderer) Layout(size fyne.Size) {
+	r.box.Layout(r.Objects(), size)
+}
+
+func (r *menuBarRenderer) MinSize() fyne.Size {
+	return r.box.MinSize(r.Objects())
+}
+
+func (r *menuBarRenderer) Refresh() {
+	canvas.Refresh(r.b)
+}
+
+// menuBarItem is a single top level entry of a MenuBar.
+type menuBarItem struct {
+	widget.Base
+	Menu   *fyne.Menu
+	Parent *MenuBar
+
+	child   *Menu
+	hovered bool
+}
+
+func newMenuBarItem(menu *fyne.Menu, parent *MenuBar) *menuBarItem {
+	return &menuBarItem{Menu: menu, Parent: parent}
+}
+
+// CreateRenderer returns a new renderer for the menu bar item.
+// Implements: fyne.Widget
+func (i *menuBarItem) CreateRenderer() fyne.WidgetRenderer {
+	text := canvas.NewText(i.Menu.Label, theme.TextColor())
+	if i.child == nil {
+		child := NewMenu(i.Menu)
+		child.Hide()
+		child.DismissAction = i.Parent.deactivate
+		child.leaveAction = func() { i.Parent.activateAt(i.Parent.activeIndex() - 1) }
+		child.advanceAction = func() { i.Parent.activateAt(i.Parent.activeIndex() + 1) }
+		i.child = child
+	}
+	return &menuBarItemRenderer{
+		BaseRenderer: widget.NewBaseRenderer([]fyne.CanvasObject{text, i.child}),
+		i:            i,
+		text:         text,
+	}
+}
+
+// Hide hides the menu bar item.
+// Implements: fyne.Widget
+func (i *menuBarItem) Hide() {
+	widget.HideWidget(&i.Base, i)
+}
+
+// MinSize returns the minimal size of the menu bar item.
+// Implements: fyne.Widget
+func (i *menuBarItem) MinSize() fyne.Size {
+	return widget.MinSizeOf(i)
+}
+
+// MouseIn hovers the item and, if another menu on the bar is already open, switches to this one.
+// Implements: desktop.Hoverable
+func (i *menuBarItem) MouseIn(*desktop.MouseEvent) {
+	i.hovered = true
+	if i.Parent.active {
+		i.Parent.activate(i)
+	}
+	i.Refresh()
+}
+
+// MouseMoved does nothing.
+// Implements: desktop.Hoverable
+func (i *menuBarItem) MouseMoved(*desktop.MouseEvent) {
+}
+
+// MouseOut unhovers the item without affecting the visibility of its menu.
+// Implements: desktop.Hoverable
+func (i *menuBarItem) MouseOut() {
+	i.hovered = false
+	i.Refresh()
+}
+
+// Refresh triggers a redraw of the menu bar item.
+// Implements: fyne.Widget
+func (i *menuBarItem) Refresh() {
+	widget.RefreshWidget(i)
+}
+
+// Resize changes the size of the menu bar item.
+// Implements: fyne.Widget
+func (i *menuBarItem) Resize(size fyne.Size) {
+	widget.ResizeWidget(&i.Base, i, size)
+	if i.child != nil {
+		i.updateChildPosition()
+	}
+}
+
+// Show makes the menu bar item visible.
+// Implements: fyne.Widget
+func (i *menuBarItem) Show() {
+	widget.ShowWidget(&i.Base, i)
+}
+
+// Tapped opens this item's menu, or closes it if already open.
+// Implements: fyne.Tappable
+func (i *menuBarItem) Tapped(*fyne.PointEvent) {
+	i.Parent.toggle(i)
+}
+
+func (i *menuBarItem) openMenu() {
+	if i.child.Size().IsZero() {
+		i.child.Resize(i.child.MinSize())
+		i.updateChildPosition()
+	}
+	i.child.Show()
+}
+
+func (i *menuBarItem) closeMenu() {
+	i.child.DeactivateChild()
+	i.child.Hide()
+}
+
+func (i *menuBarItem) updateChildPosition() {
+	itemSize := i.Size()
+	cp := fyne.NewPos(0, itemSize.Height)
+	c := i.Parent.canvas
+	if c != nil {
+		d := fyne.CurrentApp().Driver()
+		absPos := d.AbsolutePositionForObject(i)
+		childSize := i.child.Size()
+		if absPos.X+childSize.Width > c.Size().Width {
+			cp.X = c.Size().Width - absPos.X - childSize.Width
+		}
+		if absPos.Y+itemSize.Height+childSize.Height > c.Size().Height {
+			cp.Y = -childSize.Height
+		}
+	}
+	i.child.Move(cp)
+}
+
+type menuBarItemRenderer struct {
+	widget.BaseRenderer
+	i    *menuBarItem
+	text *canvas.Text
+}
+
+func (r *menuBarItemRenderer) BackgroundColor() color.Color {
+	if r.i.hovered || (r.i.child != nil && r.i.child.Visible()) {
+		return theme.HoverColor()
+	}
+	return color.Transparent
+}
+
+func (r *menuBarItemRenderer) Layout(fyne.Size) {
+	padding := r.itemPadding()
+
+	r.text.TextSize = theme.TextSize()
+	r.text.Color = theme.TextColor()
+	r.text.Resize(r.text.MinSize())
+	r.text.Move(fyne.NewPos(padding.Width/2, padding.Height/2))
+}
+
+func (r *menuBarItemRenderer) MinSize() fyne.Size {
+	return r.text.MinSize().Add(r.itemPadding())
+}
+
+func (r *menuBarItemRenderer) Refresh() {
+	canvas.Refresh(r.i)
+}
+
+func (r *menuBarItemRenderer) itemPadding() fyne.Size {
+	return fyne.NewSize(theme.Padding()*4, theme.Padding()*2)
+}
@@ -0,0 +1,25 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMenuBar_OpenMenuBubblesLeftAndRightBetweenTopLevelItems(t *testing.T) {
+	main := fyne.NewMainMenu(
+		fyne.NewMenu("File", fyne.NewMenuItem("New", nil)),
+		fyne.NewMenu("Edit", fyne.NewMenuItem("Copy", nil)),
+	)
+	bar := NewMenuBar(main, nil)
+	bar.Items[0].CreateRenderer()
+	bar.Items[1].CreateRenderer()
+
+	bar.activate(bar.Items[0])
+	bar.Items[0].child.advanceAction()
+	assert.Equal(t, bar.Items[1], bar.activeItem, "Right with a menu open must move to the next top level item")
+
+	bar.Items[1].child.leaveAction()
+	assert.Equal(t, bar.Items[0], bar.activeItem, "Left with a menu open must move to the previous top level item")
+}
@@ -51,11 +51,23 @@ func (i *menuItem) CreateRenderer() fyne.WidgetRenderer {
 		}
 		objects = append(objects, i.child)
 	}
+	var accel *canvas.Text
+	if i.Item.Accelerator != nil {
+		accel = canvas.NewText(i.Item.Accelerator.ShortcutName(), theme.DisabledTextColor())
+		objects = append(objects, accel)
+	}
+	var check *canvas.Image
+	if i.Item.Checkable {
+		check = canvas.NewImageFromResource(theme.ConfirmIcon())
+		objects = append(objects, check)
+	}
 	return &menuItemRenderer{
 		BaseRenderer: widget.NewBaseRenderer(objects),
 		i:            i,
 		icon:         icon,
 		text:         text,
+		accel:        accel,
+		check:        check,
 	}
 }
 
@@ -117,6 +129,21 @@ func (i *menuItem) Show() {
 // It does nothing if the item doesn’t have an action.
 // Implements: fyne.Tappable
 func (i *menuItem) Tapped(*fyne.PointEvent) {
+	if i.Item.Checkable {
+		if i.Item.RadioGroup != "" {
+			// Re-selecting the active radio entry is a no-op; only switching
+			// to a different member of the group should change anything.
+			if !i.Item.Checked {
+				i.Item.Checked = true
+				i.clearRadioGroupSiblings()
+				i.Refresh()
+			}
+		} else {
+			i.Item.Checked = !i.Item.Checked
+			i.Refresh()
+		}
+	}
+
 	if i.Item.Action == nil {
 		if fyne.CurrentDevice().IsMobile() {
 			i.activateChild()
@@ -129,6 +156,35 @@ func (i *menuItem) Tapped(*fyne.PointEvent) {
 	i.Parent.Dismiss()
 }
 
+// clearRadioGroupSiblings unchecks every other item of the parent menu that
+// shares this item's RadioGroup.
+func (i *menuItem) clearRadioGroupSiblings() {
+	if i.Parent == nil {
+		return
+	}
+	for _, sibling := range i.Parent.menuItems() {
+		if sibling == i || sibling.Item.RadioGroup != i.Item.RadioGroup {
+			continue
+		}
+		sibling.Item.Checked = false
+		sibling.Refresh()
+	}
+}
+
+// checkGutterWidth returns the width reserved for the check column, shared
+// uniformly by every item of the parent menu so labels stay aligned.
+func (i *menuItem) checkGutterWidth() float32 {
+	if i.Parent == nil {
+		return 0
+	}
+	for _, sibling := range i.Parent.menuItems() {
+		if sibling.Item.Checkable {
+			return theme.IconInlineSize()
+		}
+	}
+	return 0
+}
+
 func (i *menuItem) activateChild() {
 	if i.child != nil {
 		i.child.DeactivateChild()
@@ -150,29 +206,41 @@ func (i *menuItem) activateChild() {
 
 func (i *menuItem) updateChildPosition() {
 	itemSize := i.Size()
-	cp := fyne.NewPos(itemSize.Width, -theme.Padding())
+	preferred := fyne.NewPos(itemSize.Width, -theme.Padding())
 	d := fyne.CurrentApp().Driver()
 	c := d.CanvasForObject(i)
+	cp := preferred
 	if c != nil {
-		absPos := d.AbsolutePositionForObject(i)
-		childSize := i.child.Size()
-		if absPos.X+itemSize.Width+childSize.Width > c.Size().Width {
-			if absPos.X-childSize.Width >= 0 {
-				cp.X = -childSize.Width
-			} else {
-				cp.X = c.Size().Width - absPos.X - childSize.Width
-			}
-		}
-		if absPos.Y+childSize.Height-theme.Padding() > c.Size().Height {
-			cp.Y = c.Size().Height - absPos.Y - childSize.Height
-		}
+		cp = calculateSubmenuPosition(preferred, d.AbsolutePositionForObject(i), itemSize, i.child.Size(), c.Size())
 	}
 	i.child.Move(cp)
 }
 
+// calculateSubmenuPosition returns where, relative to itemAbsPos, a childSize submenu hanging
+// off preferred should be moved to so that it doesn't overflow canvasSize. If it would overflow
+// the right edge it flips to the opposite side of the item, or, where that side has no room
+// either, is shifted so its right edge meets the canvas's; if it would overflow the bottom edge
+// it is shifted up so its bottom edge meets the canvas's.
+func calculateSubmenuPosition(preferred, itemAbsPos fyne.Position, itemSize, childSize, canvasSize fyne.Size) fyne.Position {
+	cp := preferred
+	if itemAbsPos.X+itemSize.Width+childSize.Width > canvasSize.Width {
+		if itemAbsPos.X-childSize.Width >= 0 {
+			cp.X = -childSize.Width
+		} else {
+			cp.X = canvasSize.Width - itemAbsPos.X - childSize.Width
+		}
+	}
+	if itemAbsPos.Y+childSize.Height+preferred.Y > canvasSize.Height {
+		cp.Y = canvasSize.Height - itemAbsPos.Y - childSize.Height
+	}
+	return cp
+}
+
 type menuItemRenderer struct {
 	widget.BaseRenderer
 	i                *menuItem
+	accel            *canvas.Text
+	check            *canvas.Image
 	icon             *canvas.Image
 	lastThemePadding int
 	minSize          fyne.Size
@@ -189,16 +257,38 @@ func (r *menuItemRenderer) BackgroundColor() color.Color {
 
 func (r *menuItemRenderer) Layout(size fyne.Size) {
 	padding := r.itemPadding()
+	gutter := r.i.checkGutterWidth()
 
 	r.text.TextSize = theme.TextSize()
 	r.text.Color = theme.TextColor()
 	r.text.Resize(r.text.MinSize())
-	r.text.Move(fyne.NewPos(padding.Width/2, padding.Height/2))
+	r.text.Move(fyne.NewPos(gutter+padding.Width/2, padding.Height/2))
+
+	if r.check != nil {
+		if r.i.Item.Checked {
+			r.check.Show()
+		} else {
+			r.check.Hide()
+		}
+		r.check.Resize(fyne.NewSize(theme.IconInlineSize(), theme.IconInlineSize()))
+		r.check.Move(fyne.NewPos(padding.Width/2, (size.Height-theme.IconInlineSize())/2))
+	}
 
 	if r.icon != nil {
 		r.icon.Resize(fyne.NewSize(theme.IconInlineSize(), theme.IconInlineSize()))
 		r.icon.Move(fyne.NewPos(size.Width-theme.IconInlineSize(), (size.Height-theme.IconInlineSize())/2))
 	}
+
+	if r.accel != nil {
+		r.accel.TextSize = theme.TextSize()
+		r.accel.Color = theme.DisabledTextColor()
+		r.accel.Resize(r.accel.MinSize())
+		accelX := size.Width - padding.Width/2 - r.accel.MinSize().Width
+		if r.icon != nil {
+			accelX -= theme.IconInlineSize()
+		}
+		r.accel.Move(fyne.NewPos(accelX, (size.Height-r.accel.MinSize().Height)/2))
+	}
 }
 
 func (r *menuItemRenderer) MinSize() fyne.Size {
@@ -207,9 +297,15 @@ func (r *menuItemRenderer) MinSize() fyne.Size {
 	}
 
 	minSize := r.text.MinSize().Add(r.itemPadding())
+	if gutter := r.i.checkGutterWidth(); gutter > 0 {
+		minSize = minSize.Add(fyne.NewSize(gutter, 0))
+	}
 	if r.icon != nil {
 		minSize = minSize.Add(fyne.NewSize(theme.IconInlineSize(), 0))
 	}
+	if r.accel != nil {
+		minSize = minSize.Add(fyne.NewSize(r.accel.MinSize().Width+theme.Padding(), 0))
+	}
 	r.minSize = minSize
 	return r.minSize
 }
@@ -222,6 +318,7 @@ func (r *menuItemRenderer) minSizeUnchanged() bool {
 	return !r.minSize.IsZero() &&
 		r.text.TextSize == theme.TextSize() &&
 		(r.icon == nil || r.icon.Size().Width == theme.IconInlineSize()) &&
+		(r.accel == nil || r.accel.Size().Width == r.accel.MinSize().Width) &&
 		r.lastThemePadding == theme.Padding()
 }
 
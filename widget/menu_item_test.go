@@ -0,0 +1,32 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMenuItem_Tapped_RadioGroup(t *testing.T) {
+	one := fyne.NewMenuItem("One", nil)
+	one.Checkable = true
+	one.RadioGroup = "group"
+	one.Checked = true
+
+	two := fyne.NewMenuItem("Two", nil)
+	two.Checkable = true
+	two.RadioGroup = "group"
+
+	parent := NewMenu(fyne.NewMenu("", one, two))
+	items := parent.menuItems()
+
+	// Selecting the already-checked entry must be a no-op, leaving it checked.
+	items[0].Tapped(&fyne.PointEvent{})
+	assert.True(t, one.Checked)
+	assert.False(t, two.Checked)
+
+	// Selecting the other entry switches the check mark across the group.
+	items[1].Tapped(&fyne.PointEvent{})
+	assert.False(t, one.Checked)
+	assert.True(t, two.Checked)
+}
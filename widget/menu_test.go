@@ -0,0 +1,29 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMenu_TypedKey_LeftCallsLeaveActionWhenNoActiveChild(t *testing.T) {
+	m := NewMenu(fyne.NewMenu("", fyne.NewMenuItem("One", nil)))
+	called := false
+	m.leaveAction = func() { called = true }
+
+	m.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft})
+
+	assert.True(t, called, "Left must bubble to leaveAction when this menu has no open submenu of its own")
+}
+
+func TestMenu_TypedKey_RightCallsAdvanceActionWhenActiveItemHasNoChild(t *testing.T) {
+	m := NewMenu(fyne.NewMenu("", fyne.NewMenuItem("One", nil)))
+	m.activateFirst()
+	called := false
+	m.advanceAction = func() { called = true }
+
+	m.TypedKey(&fyne.KeyEvent{Name: fyne.KeyRight})
+
+	assert.True(t, called, "Right must bubble to advanceAction when the active item has no submenu to open")
+}
@@ -0,0 +1,20 @@
+package widget
+
+import "fyne.io/fyne"
+
+// ShowPopUpMenuAtPosition creates a new PopUpMenu populated with menu's items and displays it
+// on c anchored at pos. If it would overflow c's right edge it flips to the left of pos, or, where
+// there is no room there either, is shifted left so its right edge meets c's; if it would overflow
+// c's bottom edge it is shifted up so its bottom edge meets c's. This reuses the exact
+// edge-handling menuItem uses for its submenus, so the two call sites can't drift apart.
+//
+// Since: 1.4
+func ShowPopUpMenuAtPosition(menu *fyne.Menu, c fyne.Canvas, pos fyne.Position) *PopUpMenu {
+	pop := NewPopUpMenu(menu, c)
+
+	size := pop.MinSize()
+	offset := calculateSubmenuPosition(fyne.NewPos(0, 0), pos, fyne.NewSize(0, 0), size, c.Size())
+	pop.Move(pos.Add(offset))
+
+	return pop
+}
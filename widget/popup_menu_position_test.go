@@ -0,0 +1,23 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateSubmenuPosition_FlipsToOppositeSideWhenOverflowing(t *testing.T) {
+	canvasSize := fyne.NewSize(200, 200)
+	itemSize := fyne.NewSize(0, 0)
+	childSize := fyne.NewSize(60, 40)
+
+	// Anchored near the right edge, with room to spare on the left: flips there.
+	pos := calculateSubmenuPosition(fyne.NewPos(0, 0), fyne.NewPos(180, 10), itemSize, childSize, canvasSize)
+	assert.Equal(t, float32(-60), pos.X)
+
+	// Anchored such that neither side fully fits: clamps so the popup's trailing
+	// edge lands on the canvas's trailing edge instead of overflowing it.
+	pos = calculateSubmenuPosition(fyne.NewPos(0, 0), fyne.NewPos(50, 10), itemSize, fyne.NewSize(180, 40), canvasSize)
+	assert.Equal(t, float32(-30), pos.X)
+}
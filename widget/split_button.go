@@ -0,0 +1,102 @@
+package widget
+
+import (
+	"fyne.io/fyne"
+	"fyne.io/fyne/canvas"
+	"fyne.io/fyne/theme"
+)
+
+// SplitButton is a Button with an attached dropdown Menu: tapping the main region
+// triggers OnTapped as usual, while tapping the caret on its trailing edge opens Menu.
+//
+// Since: 1.4
+type SplitButton struct {
+	Button
+	Menu *fyne.Menu
+}
+
+// NewSplitButton creates a split button with the given label, tap handler and attached menu.
+//
+// Since: 1.4
+func NewSplitButton(label string, tapped func(), menu *fyne.Menu) *SplitButton {
+	b := &SplitButton{
+		Button: Button{Text: label, OnTapped: tapped},
+		Menu:   menu,
+	}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer
+func (b *SplitButton) CreateRenderer() fyne.WidgetRenderer {
+	br := b.Button.CreateRenderer().(*buttonRenderer)
+	b.ExtendBaseWidget(b)
+
+	divider := canvas.NewRectangle(theme.DisabledTextColor())
+	caret := canvas.NewImageFromResource(theme.MenuExpandIcon())
+	br.SetObjects(append(br.Objects(), divider, caret))
+
+	return &splitButtonRenderer{buttonRenderer: br, b: b, divider: divider, caret: caret}
+}
+
+// Tapped invokes OnTapped for a tap on the main region, or opens Menu for a tap on the caret.
+// Implements: fyne.Tappable
+func (b *SplitButton) Tapped(evt *fyne.PointEvent) {
+	if b.Disabled() {
+		return
+	}
+
+	if evt.Position.X >= b.Size().Width-b.caretZoneWidth() {
+		b.openMenu()
+		return
+	}
+
+	b.Button.Tapped(evt)
+}
+
+func (b *SplitButton) caretZoneWidth() float32 {
+	return theme.IconInlineSize() + theme.Padding()*2
+}
+
+func (b *SplitButton) openMenu() {
+	if b.Menu == nil {
+		return
+	}
+
+	d := fyne.CurrentApp().Driver()
+	c := d.CanvasForObject(b)
+	if c == nil {
+		return
+	}
+
+	pos := d.AbsolutePositionForObject(b).Add(fyne.NewPos(0, b.Size().Height))
+	ShowPopUpMenuAtPosition(b.Menu, c, pos)
+}
+
+type splitButtonRenderer struct {
+	*buttonRenderer
+	b       *SplitButton
+	divider *canvas.Rectangle
+	caret   *canvas.Image
+}
+
+func (r *splitButtonRenderer) Layout(size fyne.Size) {
+	caretZone := r.b.caretZoneWidth()
+	r.buttonRenderer.Layout(fyne.NewSize(size.Width-caretZone, size.Height))
+
+	r.divider.Resize(fyne.NewSize(1, size.Height-theme.Padding()))
+	r.divider.Move(fyne.NewPos(size.Width-caretZone, theme.Padding()/2))
+
+	r.caret.Resize(fyne.NewSize(theme.IconInlineSize(), theme.IconInlineSize()))
+	r.caret.Move(fyne.NewPos(size.Width-caretZone+theme.Padding(), (size.Height-theme.IconInlineSize())/2))
+}
+
+func (r *splitButtonRenderer) MinSize() fyne.Size {
+	return r.buttonRenderer.MinSize().Add(fyne.NewSize(r.b.caretZoneWidth(), 0))
+}
+
+func (r *splitButtonRenderer) Refresh() {
+	r.divider.FillColor = theme.DisabledTextColor()
+	r.divider.Refresh()
+	r.buttonRenderer.Refresh()
+}
@@ -0,0 +1,28 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne"
+	"fyne.io/fyne/theme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitButton_CaretZoneWidth(t *testing.T) {
+	b := NewSplitButton("Hi", nil, fyne.NewMenu(""))
+	assert.Equal(t, theme.IconInlineSize()+theme.Padding()*2, b.caretZoneWidth())
+}
+
+func TestSplitButton_Tapped_SplitsMainRegionFromCaretZone(t *testing.T) {
+	tapped := false
+	// Menu is left nil so a tap landing in the caret zone is a safe no-op to assert against.
+	b := NewSplitButton("Hi", func() { tapped = true }, nil)
+	b.Resize(fyne.NewSize(100, 40))
+
+	b.Tapped(&fyne.PointEvent{Position: fyne.NewPos(b.Size().Width-b.caretZoneWidth()-1, 20)})
+	assert.True(t, tapped, "a tap just left of the caret zone must trigger OnTapped")
+
+	tapped = false
+	b.Tapped(&fyne.PointEvent{Position: fyne.NewPos(b.Size().Width-1, 20)})
+	assert.False(t, tapped, "a tap inside the caret zone must open the menu instead of triggering OnTapped")
+}